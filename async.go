@@ -0,0 +1,188 @@
+package listeners
+
+import (
+	"context"
+	"sync"
+)
+
+// defaultAsyncQueueSize is the worker queue capacity used when a
+// ListenerManager was created with NewListener instead of
+// NewListenerWithAsync.
+const defaultAsyncQueueSize = 16
+
+// singleWorkerPool runs submitted tasks one at a time, in the order they
+// were submitted, on a single background goroutine. It gives each listener
+// its own FIFO lane so a slow listener cannot stall another's events.
+type singleWorkerPool struct {
+	tasks chan func()
+	done  chan struct{}
+	wg    sync.WaitGroup
+}
+
+func newSingleWorkerPool(queueSize int) *singleWorkerPool {
+	if queueSize <= 0 {
+		queueSize = defaultAsyncQueueSize
+	}
+
+	wp := &singleWorkerPool{
+		tasks: make(chan func(), queueSize),
+		done:  make(chan struct{}),
+	}
+
+	wp.wg.Add(1)
+	go wp.run()
+
+	return wp
+}
+
+func (wp *singleWorkerPool) run() {
+	defer wp.wg.Done()
+
+	for {
+		select {
+		case task := <-wp.tasks:
+			task()
+		case <-wp.done:
+			return
+		}
+	}
+}
+
+// submit enqueues task, blocking if the queue is full. It is a no-op once
+// the pool has been stopped.
+func (wp *singleWorkerPool) submit(task func()) {
+	select {
+	case wp.tasks <- task:
+	case <-wp.done:
+	}
+}
+
+// stop halts the worker goroutine and waits for the in-flight task, if
+// any, to finish. The worker picks at random between a queued task and the
+// stop signal, so some tasks still queued may run before it exits; the
+// rest are discarded.
+func (wp *singleWorkerPool) stop() {
+	close(wp.done)
+	wp.wg.Wait()
+}
+
+// poolFor returns the worker pool dedicated to listener id, creating it
+// lazily on first use. It refuses to (re-)create a pool for an id that is
+// no longer registered, returning ok == false instead — otherwise a pool
+// created after Remove has already swept id would never be reclaimed by
+// Remove's stopPool call, leaking its goroutine and letting the dispatched
+// task run against listener state the caller has already torn down (e.g.
+// a Subscription's channel, already closed by Unsubscribe).
+func (cm *ListenerManager[T]) poolFor(id ListenerID) (pool *singleWorkerPool, ok bool) {
+	cm.poolsMu.Lock()
+	defer cm.poolsMu.Unlock()
+
+	if cm.pools == nil {
+		cm.pools = make(map[ListenerID]*singleWorkerPool)
+	}
+
+	if pool, ok = cm.pools[id]; ok {
+		return pool, true
+	}
+
+	cm.mu.RLock()
+	_, alive := cm.listeners[id]
+	cm.mu.RUnlock()
+	if !alive {
+		return nil, false
+	}
+
+	pool = newSingleWorkerPool(cm.asyncQueueSize)
+	cm.pools[id] = pool
+
+	return pool, true
+}
+
+// stopPool stops and discards the worker pool dedicated to listener id, if
+// one was ever created.
+func (cm *ListenerManager[T]) stopPool(id ListenerID) {
+	cm.poolsMu.Lock()
+	pool, ok := cm.pools[id]
+	if ok {
+		delete(cm.pools, id)
+	}
+	cm.poolsMu.Unlock()
+
+	if ok {
+		pool.stop()
+	}
+}
+
+// InvokePreAsync dispatches each Pre listener's callback onto its own
+// single-worker pool, keyed by ListenerID, so a given listener's events
+// execute in FIFO order without blocking other listeners. Unlike
+// InvokePre, results are not collected and Handled/Stop have no effect on
+// other listeners, since dispatch does not wait for completion.
+func (cm *ListenerManager[T]) InvokePreAsync(invokeFunc func(T) PluginResult) {
+	cm.dispatchAsync(Pre, func(cb T) { invokeFunc(cb) })
+}
+
+// InvokePostAsync dispatches each Post listener's callback onto its own
+// single-worker pool, keyed by ListenerID, so a given listener's events
+// execute in FIFO order without blocking other listeners or the caller.
+func (cm *ListenerManager[T]) InvokePostAsync(invokeFunc func(T)) {
+	cm.dispatchAsync(Post, invokeFunc)
+}
+
+func (cm *ListenerManager[T]) dispatchAsync(mode HookMode, invokeFunc func(T)) {
+	cm.mu.RLock()
+
+	type dispatch struct {
+		id       ListenerID
+		callback T
+	}
+	targets := make([]dispatch, 0, len(cm.order))
+	for _, idx := range cm.order {
+		holder := cm.listeners[idx]
+		if holder.mode == mode {
+			targets = append(targets, dispatch{idx, holder.callback})
+		}
+	}
+
+	cm.mu.RUnlock()
+
+	for _, t := range targets {
+		pool, ok := cm.poolFor(t.id)
+		if !ok {
+			continue
+		}
+
+		callback := t.callback
+		pool.submit(func() { invokeFunc(callback) })
+	}
+}
+
+// Close stops every per-listener worker pool, waiting for any task already
+// running; tasks still queued may or may not run before their pool stops.
+func (cm *ListenerManager[T]) Close() {
+	cm.poolsMu.Lock()
+	pools := cm.pools
+	cm.pools = nil
+	cm.poolsMu.Unlock()
+
+	for _, pool := range pools {
+		pool.stop()
+	}
+}
+
+// Shutdown behaves like Close but returns early with ctx.Err() if ctx is
+// done before every worker pool has stopped.
+func (cm *ListenerManager[T]) Shutdown(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		cm.Close()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
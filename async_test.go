@@ -0,0 +1,121 @@
+package listeners
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestInvokePostAsyncPreservesPerListenerOrder dispatches from many
+// concurrent goroutines but serializes each goroutine's submission with
+// recording its place in line, so the test can assert the listener's
+// single-worker pool delivers events in exactly the order they were
+// submitted -- concurrent dispatch must not reorder a given listener's
+// own events.
+func TestInvokePostAsyncPreservesPerListenerOrder(t *testing.T) {
+	cm := NewListenerWithAsync[func(int)](64)
+	defer cm.Close()
+
+	var mu sync.Mutex
+	var seen []int
+	done := make(chan struct{})
+
+	const total = 50
+	var received atomic.Int32
+	cm.Add(func(n int) {
+		mu.Lock()
+		seen = append(seen, n)
+		mu.Unlock()
+
+		if received.Add(1) == total {
+			close(done)
+		}
+	}, Post)
+
+	var submitMu sync.Mutex
+	var wantOrder []int
+	var wg sync.WaitGroup
+	for i := 0; i < total; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			submitMu.Lock()
+			defer submitMu.Unlock()
+			wantOrder = append(wantOrder, i)
+			cm.InvokePostAsync(func(cb func(int)) { cb(i) })
+		}()
+	}
+	wg.Wait()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for all async dispatches to land")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(seen) != len(wantOrder) {
+		t.Fatalf("received %d events, want %d", len(seen), len(wantOrder))
+	}
+	for i := range wantOrder {
+		if seen[i] != wantOrder[i] {
+			t.Fatalf("seen = %v, want %v (submission order)", seen, wantOrder)
+		}
+	}
+}
+
+func TestInvokePostAsyncSlowListenerDoesNotStallOthers(t *testing.T) {
+	cm := NewListenerWithAsync[func()](4)
+	defer cm.Close()
+
+	slowStarted := make(chan struct{})
+	slowRelease := make(chan struct{})
+	cm.Add(func() {
+		close(slowStarted)
+		<-slowRelease
+	}, Post)
+
+	fastDone := make(chan struct{})
+	cm.Add(func() {
+		close(fastDone)
+	}, Post)
+
+	cm.InvokePostAsync(func(cb func()) { cb() })
+
+	select {
+	case <-slowStarted:
+	case <-time.After(time.Second):
+		t.Fatal("slow listener never started")
+	}
+
+	select {
+	case <-fastDone:
+	case <-time.After(time.Second):
+		t.Fatal("fast listener was stalled by the slow listener's worker pool")
+	}
+
+	close(slowRelease)
+}
+
+func TestRemoveReclaimsListenerWorkerPool(t *testing.T) {
+	cm := NewListenerWithAsync[func()](4)
+
+	id := cm.Add(func() {}, Post)
+	cm.InvokePostAsync(func(cb func()) { cb() })
+
+	// Give the lazily-created pool a moment to exist before removing it.
+	time.Sleep(10 * time.Millisecond)
+	cm.Remove(id)
+
+	cm.poolsMu.Lock()
+	_, stillTracked := cm.pools[id]
+	cm.poolsMu.Unlock()
+	if stillTracked {
+		t.Fatal("Remove did not reclaim the listener's worker pool")
+	}
+
+	cm.Close()
+}
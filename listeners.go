@@ -1,8 +1,10 @@
 package listeners
 
 import (
+	"reflect"
 	"slices"
 	"sync"
+	"sync/atomic"
 )
 
 // PluginResult represents the result of a callback execution.
@@ -26,6 +28,12 @@ const (
 type listenerHolder[T any] struct {
 	callback T
 	mode     HookMode
+	priority int
+	// remaining counts down the invocations left for a count-limited
+	// listener added via AddOnce/AddN. It is nil for listeners with no
+	// invocation limit. It is a pointer so it keeps working as a shared
+	// counter across the holder copies handed out by map reads.
+	remaining *atomic.Int32
 }
 
 // ListenerID is a unique identifier of a registered listener.
@@ -38,6 +46,10 @@ type ListenerManager[T any] struct {
 	order     []ListenerID
 	id        ListenerID
 	mu        sync.RWMutex
+
+	asyncQueueSize int
+	pools          map[ListenerID]*singleWorkerPool
+	poolsMu        sync.Mutex
 }
 
 // NewListener creates and initializes a new ListenerManager instance.
@@ -47,37 +59,150 @@ func NewListener[T any]() *ListenerManager[T] {
 	}
 }
 
-// Add registers a new listener with the specified hook mode.
+// NewListenerWithAsync creates and initializes a new ListenerManager
+// instance whose InvokePreAsync/InvokePostAsync dispatch onto per-listener
+// worker pools bounded to workerQueueSize pending tasks each.
+func NewListenerWithAsync[T any](workerQueueSize int) *ListenerManager[T] {
+	cm := NewListener[T]()
+	cm.asyncQueueSize = workerQueueSize
+
+	return cm
+}
+
+// Add registers a new listener with the specified hook mode and the
+// default priority of 0.
 //
 // Returns a unique ListenerID that can be used to remove the listener later.
 func (cm *ListenerManager[T]) Add(callback T, mode HookMode) ListenerID {
+	return cm.AddWithPriority(callback, mode, 0)
+}
+
+// AddWithPriority registers a new listener with the specified hook mode,
+// executed in descending priority order relative to other listeners.
+// Listeners sharing the same priority run in the order they were added.
+//
+// Returns a unique ListenerID that can be used to remove the listener later.
+func (cm *ListenerManager[T]) AddWithPriority(callback T, mode HookMode, priority int) ListenerID {
+	return cm.add(callback, mode, priority, nil)
+}
+
+// AddOnce registers a listener that is automatically removed after it has
+// been invoked exactly once by InvokePre or InvokePost. Invocations through
+// InvokePreAsync/InvokePostAsync are not counted and do not remove it.
+//
+// Returns a unique ListenerID that can be used to remove the listener early.
+func (cm *ListenerManager[T]) AddOnce(callback T, mode HookMode) ListenerID {
+	return cm.AddN(callback, mode, 1)
+}
+
+// AddN registers a listener that is automatically removed after it has
+// been invoked n times by InvokePre or InvokePost. Invocations through
+// InvokePreAsync/InvokePostAsync are not counted and do not remove it.
+// n must be greater than zero.
+//
+// Returns a unique ListenerID that can be used to remove the listener early.
+func (cm *ListenerManager[T]) AddN(callback T, mode HookMode, n int) ListenerID {
+	remaining := &atomic.Int32{}
+	remaining.Store(int32(n))
+
+	return cm.add(callback, mode, 0, remaining)
+}
+
+func (cm *ListenerManager[T]) add(callback T, mode HookMode, priority int, remaining *atomic.Int32) ListenerID {
 	cm.mu.Lock()
 
 	id := cm.id
 	cm.listeners[id] = listenerHolder[T]{
-		callback,
-		mode,
+		callback:  callback,
+		mode:      mode,
+		priority:  priority,
+		remaining: remaining,
 	}
-
-	cm.order = append(cm.order, id)
 	cm.id++
 
+	pos, _ := slices.BinarySearchFunc(cm.order, id, func(existing, target ListenerID) int {
+		if existing == target {
+			return 0
+		}
+		if cm.before(existing, target) {
+			return -1
+		}
+		return 1
+	})
+	cm.order = slices.Insert(cm.order, pos, id)
+
 	cm.mu.Unlock()
 
 	return id
 }
 
+// before reports whether listener a must execute before listener b, i.e.
+// a has a strictly higher priority, or an equal priority and a lower
+// (earlier) ListenerID.
+func (cm *ListenerManager[T]) before(a, b ListenerID) bool {
+	ha, hb := cm.listeners[a], cm.listeners[b]
+	if ha.priority != hb.priority {
+		return ha.priority > hb.priority
+	}
+	return a < b
+}
+
 // Remove unregisters a listener by its ListenerID.
 // If the listener does not exist, the call has no effect.
 func (cm *ListenerManager[T]) Remove(index ListenerID) {
 	cm.mu.Lock()
 
 	delete(cm.listeners, index)
-	cm.order = slices.DeleteFunc(cm.order, func(i ListenerID) bool {
-		return cm.order[i] == index
+	cm.order = slices.DeleteFunc(cm.order, func(id ListenerID) bool {
+		return id == index
 	})
 
 	cm.mu.Unlock()
+
+	cm.stopPool(index)
+}
+
+// RemoveFunc removes every listener whose callback matches match.
+//
+// Returns the number of listeners removed.
+func (cm *ListenerManager[T]) RemoveFunc(match func(T) bool) int {
+	cm.mu.Lock()
+
+	var removed []ListenerID
+	for _, id := range cm.order {
+		if match(cm.listeners[id].callback) {
+			removed = append(removed, id)
+		}
+	}
+
+	for _, id := range removed {
+		delete(cm.listeners, id)
+	}
+	cm.order = slices.DeleteFunc(cm.order, func(id ListenerID) bool {
+		return slices.Contains(removed, id)
+	})
+
+	cm.mu.Unlock()
+
+	for _, id := range removed {
+		cm.stopPool(id)
+	}
+
+	return len(removed)
+}
+
+// RemoveByCallback removes every listener registered with cb, comparing
+// callbacks by their underlying function pointer. This lets a caller who
+// only kept the callback value (e.g. a closure registered elsewhere)
+// unregister it without having stored the ListenerID returned by Add.
+//
+// Returns the number of listeners removed.
+func (cm *ListenerManager[T]) RemoveByCallback(cb T) int {
+	key := reflect.ValueOf(cb).Pointer()
+
+	return cm.RemoveFunc(func(candidate T) bool {
+		return reflect.ValueOf(candidate).Pointer() == key
+	})
 }
 
 // InvokePre invokes all listeners registered with Pre hook mode in the order they were added.
@@ -88,16 +213,26 @@ func (cm *ListenerManager[T]) Remove(index ListenerID) {
 func (cm *ListenerManager[T]) InvokePre(invokeFunc func(T) PluginResult) PluginResult {
 	cm.mu.RLock()
 
+	var expired []ListenerID
 	finalResult := Continue
 	for _, idx := range cm.order {
 		holder := cm.listeners[idx]
 		if holder.mode == Pre {
+			shouldInvoke, becameExpired := cm.acquire(holder)
+			if !shouldInvoke {
+				continue
+			}
+
 			result := invokeFunc(holder.callback)
 
 			if result > finalResult {
 				finalResult = result
 			}
 
+			if becameExpired {
+				expired = append(expired, idx)
+			}
+
 			if finalResult >= Handled {
 				break
 			}
@@ -106,6 +241,8 @@ func (cm *ListenerManager[T]) InvokePre(invokeFunc func(T) PluginResult) PluginR
 
 	cm.mu.RUnlock()
 
+	cm.sweep(expired)
+
 	return finalResult
 
 }
@@ -118,12 +255,62 @@ func (cm *ListenerManager[T]) InvokePre(invokeFunc func(T) PluginResult) PluginR
 func (cm *ListenerManager[T]) InvokePost(invokeFunc func(T)) {
 	cm.mu.RLock()
 
+	var expired []ListenerID
 	for _, idx := range cm.order {
 		holder := cm.listeners[idx]
 		if holder.mode == Post {
+			shouldInvoke, becameExpired := cm.acquire(holder)
+			if !shouldInvoke {
+				continue
+			}
+
 			invokeFunc(holder.callback)
+
+			if becameExpired {
+				expired = append(expired, idx)
+			}
 		}
 	}
 
 	cm.mu.RUnlock()
+
+	cm.sweep(expired)
+}
+
+// acquire atomically claims one invocation of a count-limited listener
+// before it runs, so concurrent InvokePre/InvokePost callers can never
+// together invoke it more times than it was registered for. shouldInvoke
+// reports whether this call won the claim; becameExpired reports whether
+// this call was the one that used up the last remaining invocation, and
+// so should be swept. Listeners with no invocation limit always report
+// (true, false).
+func (cm *ListenerManager[T]) acquire(holder listenerHolder[T]) (shouldInvoke, becameExpired bool) {
+	if holder.remaining == nil {
+		return true, false
+	}
+
+	n := holder.remaining.Add(-1)
+
+	return n >= 0, n == 0
+}
+
+// sweep removes listeners that have run out of invocations. It is called
+// after the InvokePre/InvokePost iteration has released the read lock, so
+// removal never mutates order while it is being ranged over, and only
+// upgrades to a write lock when there is actually something to remove.
+func (cm *ListenerManager[T]) sweep(expired []ListenerID) {
+	if len(expired) == 0 {
+		return
+	}
+
+	cm.mu.Lock()
+
+	for _, idx := range expired {
+		delete(cm.listeners, idx)
+	}
+	cm.order = slices.DeleteFunc(cm.order, func(id ListenerID) bool {
+		return slices.Contains(expired, id)
+	})
+
+	cm.mu.Unlock()
 }
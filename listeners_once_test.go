@@ -0,0 +1,99 @@
+package listeners
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAddOnceFiresExactlyOnce(t *testing.T) {
+	cm := NewListener[func() PluginResult]()
+
+	var calls atomic.Int32
+	cm.AddOnce(func() PluginResult {
+		calls.Add(1)
+		return Continue
+	}, Pre)
+
+	for i := 0; i < 3; i++ {
+		cm.InvokePre(func(cb func() PluginResult) PluginResult {
+			return cb()
+		})
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("calls = %d, want 1", got)
+	}
+}
+
+func TestAddOnceFiresExactlyOnceUnderConcurrentInvokePre(t *testing.T) {
+	cm := NewListener[func() PluginResult]()
+
+	var calls atomic.Int32
+	cm.AddOnce(func() PluginResult {
+		time.Sleep(time.Millisecond)
+		calls.Add(1)
+		return Continue
+	}, Pre)
+
+	const concurrency = 20
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			cm.InvokePre(func(cb func() PluginResult) PluginResult {
+				return cb()
+			})
+		}()
+	}
+	wg.Wait()
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("calls = %d, want exactly 1 under %d concurrent InvokePre callers", got, concurrency)
+	}
+}
+
+func TestAddNFiresExactlyNTimesAcrossMixedPreAndPost(t *testing.T) {
+	cm := NewListener[func(mode string) PluginResult]()
+
+	var calls atomic.Int32
+	cm.AddN(func(string) PluginResult {
+		calls.Add(1)
+		return Continue
+	}, Pre, 3)
+
+	for i := 0; i < 5; i++ {
+		cm.InvokePre(func(cb func(string) PluginResult) PluginResult {
+			return cb("pre")
+		})
+		cm.InvokePost(func(cb func(string) PluginResult) {
+			cb("post")
+		})
+	}
+
+	if got := calls.Load(); got != 3 {
+		t.Fatalf("calls = %d, want 3", got)
+	}
+}
+
+func TestAddOnceListenerIsRemovedAfterFiring(t *testing.T) {
+	cm := NewListener[func() PluginResult]()
+
+	id := cm.AddOnce(func() PluginResult {
+		return Continue
+	}, Pre)
+
+	cm.InvokePre(func(cb func() PluginResult) PluginResult {
+		return cb()
+	})
+
+	cm.mu.RLock()
+	_, stillPresent := cm.listeners[id]
+	cm.mu.RUnlock()
+
+	if stillPresent {
+		t.Fatal("AddOnce listener was not swept from the manager after firing")
+	}
+}
@@ -0,0 +1,117 @@
+package listeners
+
+import "testing"
+
+func TestInvokePreRunsHigherPriorityFirst(t *testing.T) {
+	cm := NewListener[func(*[]string) PluginResult]()
+
+	cm.AddWithPriority(func(out *[]string) PluginResult {
+		*out = append(*out, "low")
+		return Continue
+	}, Pre, -1)
+	cm.AddWithPriority(func(out *[]string) PluginResult {
+		*out = append(*out, "high")
+		return Continue
+	}, Pre, 10)
+	cm.Add(func(out *[]string) PluginResult {
+		*out = append(*out, "default")
+		return Continue
+	}, Pre)
+
+	var order []string
+	cm.InvokePre(func(cb func(*[]string) PluginResult) PluginResult {
+		return cb(&order)
+	})
+
+	want := []string{"high", "default", "low"}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("order = %v, want %v", order, want)
+		}
+	}
+}
+
+func TestInvokePreEqualPriorityPreservesFIFO(t *testing.T) {
+	cm := NewListener[func(*[]int) PluginResult]()
+
+	var order []int
+	for i := 0; i < 5; i++ {
+		i := i
+		cm.AddWithPriority(func(out *[]int) PluginResult {
+			*out = append(*out, i)
+			return Continue
+		}, Pre, 0)
+	}
+
+	cm.InvokePre(func(cb func(*[]int) PluginResult) PluginResult {
+		return cb(&order)
+	})
+
+	for i, v := range order {
+		if v != i {
+			t.Fatalf("order = %v, want 0..4 in order", order)
+		}
+	}
+}
+
+func TestRemoveDoesNotDisturbPriorityOrder(t *testing.T) {
+	cm := NewListener[func(*[]string) PluginResult]()
+
+	var order []string
+	idA := cm.AddWithPriority(func(out *[]string) PluginResult {
+		*out = append(*out, "a")
+		return Continue
+	}, Pre, 5)
+	cm.AddWithPriority(func(out *[]string) PluginResult {
+		*out = append(*out, "b")
+		return Continue
+	}, Pre, 3)
+	cm.AddWithPriority(func(out *[]string) PluginResult {
+		*out = append(*out, "c")
+		return Continue
+	}, Pre, 1)
+
+	cm.Remove(idA)
+
+	cm.InvokePre(func(cb func(*[]string) PluginResult) PluginResult {
+		return cb(&order)
+	})
+
+	want := []string{"b", "c"}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("order = %v, want %v", order, want)
+		}
+	}
+}
+
+func TestInvokePreStopRespectsPriorityOrder(t *testing.T) {
+	cm := NewListener[func(*[]string) PluginResult]()
+
+	var order []string
+	cm.AddWithPriority(func(out *[]string) PluginResult {
+		*out = append(*out, "high")
+		return Stop
+	}, Pre, 10)
+	cm.AddWithPriority(func(out *[]string) PluginResult {
+		*out = append(*out, "low")
+		return Continue
+	}, Pre, -10)
+
+	result := cm.InvokePre(func(cb func(*[]string) PluginResult) PluginResult {
+		return cb(&order)
+	})
+
+	if result != Stop {
+		t.Fatalf("result = %d, want Stop", result)
+	}
+	if len(order) != 1 || order[0] != "high" {
+		t.Fatalf("order = %v, want [high] (low should not run after Stop)", order)
+	}
+}
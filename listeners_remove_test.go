@@ -0,0 +1,163 @@
+package listeners
+
+import "testing"
+
+func TestRemoveDeletesOnlyTheTargetedListener(t *testing.T) {
+	cm := NewListener[func(*[]string) PluginResult]()
+
+	idA := cm.Add(func(out *[]string) PluginResult {
+		*out = append(*out, "a")
+		return Continue
+	}, Pre)
+	cm.Add(func(out *[]string) PluginResult {
+		*out = append(*out, "b")
+		return Continue
+	}, Pre)
+	cm.Add(func(out *[]string) PluginResult {
+		*out = append(*out, "c")
+		return Continue
+	}, Pre)
+
+	cm.Remove(idA)
+
+	var order []string
+	cm.InvokePre(func(cb func(*[]string) PluginResult) PluginResult {
+		return cb(&order)
+	})
+
+	want := []string{"b", "c"}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("order = %v, want %v", order, want)
+		}
+	}
+}
+
+func TestRemoveOnNonexistentIDIsANoOp(t *testing.T) {
+	cm := NewListener[func() PluginResult]()
+
+	cm.Add(func() PluginResult { return Continue }, Pre)
+
+	cm.Remove(999) // must not panic and must not touch the real listener
+
+	calls := 0
+	cm.InvokePre(func(cb func() PluginResult) PluginResult {
+		calls++
+		return cb()
+	})
+
+	if calls != 1 {
+		t.Fatalf("calls = %d, want 1", calls)
+	}
+}
+
+func TestRemoveFuncRemovesAllMatchingListeners(t *testing.T) {
+	cm := NewListener[func(*[]string) PluginResult]()
+
+	cm.Add(func(out *[]string) PluginResult {
+		*out = append(*out, "keep")
+		return Continue
+	}, Pre)
+	cm.Add(func(out *[]string) PluginResult {
+		*out = append(*out, "drop-1")
+		return Continue
+	}, Pre)
+	cm.Add(func(out *[]string) PluginResult {
+		*out = append(*out, "drop-2")
+		return Continue
+	}, Post)
+
+	var order []string
+	removed := cm.RemoveFunc(func(cb func(*[]string) PluginResult) bool {
+		var probe []string
+		cb(&probe)
+		return len(probe) == 1 && probe[0] != "keep"
+	})
+
+	if removed != 2 {
+		t.Fatalf("removed = %d, want 2", removed)
+	}
+
+	cm.InvokePre(func(cb func(*[]string) PluginResult) PluginResult {
+		return cb(&order)
+	})
+	cm.InvokePost(func(cb func(*[]string) PluginResult) {
+		cb(&order)
+	})
+
+	if len(order) != 1 || order[0] != "keep" {
+		t.Fatalf("order = %v, want [keep]", order)
+	}
+}
+
+func TestRemoveByCallbackUnregistersByFunctionPointer(t *testing.T) {
+	cm := NewListener[func(*int) PluginResult]()
+
+	var calls int
+	shared := func(n *int) PluginResult {
+		calls++
+		return Continue
+	}
+
+	cm.Add(shared, Pre)
+	cm.Add(shared, Post)
+	other := func(n *int) PluginResult { return Continue }
+	cm.Add(other, Pre)
+
+	removed := cm.RemoveByCallback(shared)
+	if removed != 2 {
+		t.Fatalf("removed = %d, want 2", removed)
+	}
+
+	var n int
+	cm.InvokePre(func(cb func(*int) PluginResult) PluginResult {
+		return cb(&n)
+	})
+	cm.InvokePost(func(cb func(*int) PluginResult) {
+		cb(&n)
+	})
+
+	if calls != 0 {
+		t.Fatalf("shared callback ran %d times, want 0", calls)
+	}
+}
+
+func TestRemoveFuncCompactsOrderSlice(t *testing.T) {
+	cm := NewListener[func(*[]int) PluginResult]()
+
+	var ids []ListenerID
+	for i := 0; i < 5; i++ {
+		i := i
+		ids = append(ids, cm.Add(func(out *[]int) PluginResult {
+			*out = append(*out, i)
+			return Continue
+		}, Pre))
+	}
+
+	removed := cm.RemoveFunc(func(cb func(*[]int) PluginResult) bool {
+		var probe []int
+		cb(&probe)
+		return probe[0]%2 == 0
+	})
+	if removed != 3 {
+		t.Fatalf("removed = %d, want 3", removed)
+	}
+
+	cm.mu.RLock()
+	orderLen := len(cm.order)
+	cm.mu.RUnlock()
+	if orderLen != 2 {
+		t.Fatalf("order slice has %d entries, want 2 (stale entries left behind)", orderLen)
+	}
+
+	var order []int
+	cm.InvokePre(func(cb func(*[]int) PluginResult) PluginResult {
+		return cb(&order)
+	})
+	if len(order) != 2 || order[0] != 1 || order[1] != 3 {
+		t.Fatalf("order = %v, want [1 3]", order)
+	}
+}
@@ -0,0 +1,113 @@
+package listeners
+
+import "sync"
+
+// OverflowPolicy controls what a Subscription does when its channel buffer
+// is full and a new event arrives.
+type OverflowPolicy = int32
+
+const (
+	// DropOldest discards the oldest buffered event to make room for the
+	// new one.
+	DropOldest OverflowPolicy = 0
+	// DropNewest discards the incoming event, keeping the buffer as-is.
+	DropNewest OverflowPolicy = 1
+	// Block waits for a consumer to make room, applying backpressure to
+	// the publisher.
+	Block OverflowPolicy = 2
+)
+
+// EventCallback is the callback type used by EventMux; it receives the
+// published event and returns a PluginResult like any other
+// ListenerManager callback.
+type EventCallback[E any] func(E) PluginResult
+
+// EventMux is a ListenerManager specialization for plain event payloads,
+// adding a channel-based Subscribe API on top of the usual Add/Remove and
+// InvokePre/InvokePost methods.
+type EventMux[E any] struct {
+	*ListenerManager[EventCallback[E]]
+}
+
+// NewEventMux creates and initializes a new EventMux instance.
+func NewEventMux[E any]() *EventMux[E] {
+	return &EventMux[E]{ListenerManager: NewListener[EventCallback[E]]()}
+}
+
+// Subscription is a handle to a channel-based event subscription created
+// by EventMux.Subscribe.
+type Subscription[E any] interface {
+	// Chan returns the channel events are delivered on. It is closed once
+	// Unsubscribe has been called.
+	Chan() <-chan E
+	// Unsubscribe stops delivery and closes the channel returned by Chan.
+	// It is safe to call more than once.
+	Unsubscribe()
+}
+
+type subscription[E any] struct {
+	mux    *EventMux[E]
+	id     ListenerID
+	ch     chan E
+	policy OverflowPolicy
+	once   sync.Once
+	dropMu sync.Mutex // serializes DropOldest's read-then-write against itself
+}
+
+// Subscribe registers a channel-based listener for mode and returns a
+// Subscription that delivers every published event on Chan() until
+// Unsubscribe is called. buffer sets the channel capacity; policy decides
+// what happens to a publish that arrives while the buffer is full.
+func (mx *EventMux[E]) Subscribe(mode HookMode, buffer int, policy OverflowPolicy) Subscription[E] {
+	sub := &subscription[E]{
+		mux:    mx,
+		ch:     make(chan E, buffer),
+		policy: policy,
+	}
+	sub.id = mx.Add(func(evt E) PluginResult {
+		sub.deliver(evt)
+		return Continue
+	}, mode)
+
+	return sub
+}
+
+func (s *subscription[E]) Chan() <-chan E {
+	return s.ch
+}
+
+func (s *subscription[E]) Unsubscribe() {
+	s.once.Do(func() {
+		// Remove blocks until every InvokePre/InvokePost call already in
+		// progress (and therefore any deliver it might call) has
+		// returned, so no send on s.ch can race with closing it below.
+		s.mux.Remove(s.id)
+		close(s.ch)
+	})
+}
+
+func (s *subscription[E]) deliver(evt E) {
+	switch s.policy {
+	case Block:
+		s.ch <- evt
+	case DropOldest:
+		s.dropMu.Lock()
+		for {
+			select {
+			case s.ch <- evt:
+				s.dropMu.Unlock()
+				return
+			default:
+			}
+			select {
+			case <-s.ch:
+			default:
+			}
+		}
+	default: // DropNewest
+		select {
+		case s.ch <- evt:
+		default:
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package listeners
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSubscribeDropNewestDiscardsIncomingWhenFull(t *testing.T) {
+	mx := NewEventMux[int]()
+	sub := mx.Subscribe(Post, 1, DropNewest)
+	defer sub.Unsubscribe()
+
+	mx.InvokePost(func(cb EventCallback[int]) { cb(1) })
+	mx.InvokePost(func(cb EventCallback[int]) { cb(2) })
+
+	select {
+	case got := <-sub.Chan():
+		if got != 1 {
+			t.Fatalf("got %d, want 1 (the event already buffered before the drop)", got)
+		}
+	default:
+		t.Fatal("expected the first event to still be buffered")
+	}
+
+	select {
+	case extra := <-sub.Chan():
+		t.Fatalf("unexpected extra event %d; DropNewest should have discarded the second publish", extra)
+	default:
+	}
+}
+
+func TestSubscribeDropOldestKeepsMostRecentWhenFull(t *testing.T) {
+	mx := NewEventMux[int]()
+	sub := mx.Subscribe(Post, 1, DropOldest)
+	defer sub.Unsubscribe()
+
+	mx.InvokePost(func(cb EventCallback[int]) { cb(1) })
+	mx.InvokePost(func(cb EventCallback[int]) { cb(2) })
+
+	select {
+	case got := <-sub.Chan():
+		if got != 2 {
+			t.Fatalf("got %d, want 2 (DropOldest should evict the stale event)", got)
+		}
+	default:
+		t.Fatal("expected the newest event to be buffered")
+	}
+}
+
+func TestSubscribeBlockAppliesBackpressureToASlowConsumer(t *testing.T) {
+	mx := NewEventMux[int]()
+	sub := mx.Subscribe(Post, 1, Block)
+	defer sub.Unsubscribe()
+
+	mx.InvokePost(func(cb EventCallback[int]) { cb(1) })
+
+	publishDone := make(chan struct{})
+	go func() {
+		mx.InvokePost(func(cb EventCallback[int]) { cb(2) })
+		close(publishDone)
+	}()
+
+	select {
+	case <-publishDone:
+		t.Fatal("Block publish returned before the slow consumer made room")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	if got := <-sub.Chan(); got != 1 {
+		t.Fatalf("got %d, want 1", got)
+	}
+
+	select {
+	case <-publishDone:
+	case <-time.After(time.Second):
+		t.Fatal("Block publish never unblocked after the consumer drained the buffer")
+	}
+
+	if got := <-sub.Chan(); got != 2 {
+		t.Fatalf("got %d, want 2", got)
+	}
+}
+
+func TestSubscriptionUnsubscribeIsIdempotent(t *testing.T) {
+	mx := NewEventMux[int]()
+	sub := mx.Subscribe(Post, 1, DropNewest)
+
+	sub.Unsubscribe()
+	sub.Unsubscribe() // must not panic on double-close
+
+	if _, open := <-sub.Chan(); open {
+		t.Fatal("channel should be closed and drained after Unsubscribe")
+	}
+}
+
+func TestSubscriptionUnsubscribeRemovesListener(t *testing.T) {
+	mx := NewEventMux[int]()
+	sub := mx.Subscribe(Post, 1, DropNewest)
+	sub.Unsubscribe()
+
+	delivered := false
+	mx.Add(func(int) PluginResult {
+		delivered = true
+		return Continue
+	}, Post)
+	mx.InvokePost(func(cb EventCallback[int]) { cb(1) })
+
+	// Only the freshly-added listener above should have run; the
+	// unsubscribed channel must be closed and empty, never delivered to.
+	if v, open := <-sub.Chan(); open {
+		t.Fatalf("unexpected delivery on an unsubscribed channel: v=%v", v)
+	}
+	if !delivered {
+		t.Fatal("sanity check listener did not run")
+	}
+}
+
+func TestSubscriptionNoGoroutineLeakAfterUnsubscribe(t *testing.T) {
+	mx := NewEventMux[int]()
+
+	before := runtime.NumGoroutine()
+
+	const n = 50
+	subs := make([]Subscription[int], n)
+	for i := range subs {
+		subs[i] = mx.Subscribe(Post, 4, Block)
+	}
+	for _, sub := range subs {
+		sub.Unsubscribe()
+	}
+
+	// Subscribe/Unsubscribe don't spawn goroutines themselves, but give
+	// the runtime a moment to settle before comparing counts.
+	time.Sleep(20 * time.Millisecond)
+	runtime.GC()
+
+	after := runtime.NumGoroutine()
+	if after > before {
+		t.Fatalf("goroutine count grew from %d to %d after subscribing and unsubscribing %d times", before, after, n)
+	}
+}
+
+// TestAsyncDispatchRacingUnsubscribeDoesNotPanic exercises the interaction
+// between EventMux's channel Subscribe/Unsubscribe and the async
+// invocation worker pools: dispatching through InvokePostAsync while
+// Unsubscribe concurrently removes and closes the subscription must never
+// deliver an event to an already-closed channel.
+func TestAsyncDispatchRacingUnsubscribeDoesNotPanic(t *testing.T) {
+	for iter := 0; iter < 20; iter++ {
+		mx := NewEventMux[int]()
+		sub := mx.Subscribe(Post, 4, DropNewest)
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				i := i
+				mx.InvokePostAsync(func(cb EventCallback[int]) { cb(i) })
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			sub.Unsubscribe()
+		}()
+
+		wg.Wait()
+		mx.Close()
+
+		for ok := true; ok; {
+			_, ok = <-sub.Chan()
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package listeners
+
+import "sync"
+
+// TopicBus is a topic-scoped publish/subscribe layer built on top of
+// ListenerManager. Callers register callbacks against a topic name and
+// publish events by topic; a separate wildcard manager fans out events to
+// listeners registered via SubscribeAll, so publishing to a topic stays
+// O(subscribers of that topic) instead of scanning every registered
+// listener.
+type TopicBus[T any] struct {
+	topics   map[string]*ListenerManager[T]
+	wildcard *ListenerManager[T]
+	mu       sync.RWMutex
+}
+
+// NewTopicBus creates and initializes a new TopicBus instance.
+func NewTopicBus[T any]() *TopicBus[T] {
+	return &TopicBus[T]{
+		topics:   make(map[string]*ListenerManager[T]),
+		wildcard: NewListener[T](),
+	}
+}
+
+// Subscribe registers callback against topic with the given hook mode.
+//
+// Returns a ListenerID that can be passed to Unsubscribe along with the
+// same topic to remove the listener later.
+func (tb *TopicBus[T]) Subscribe(topic string, cb T, mode HookMode) ListenerID {
+	tb.mu.Lock()
+	mgr, ok := tb.topics[topic]
+	if !ok {
+		mgr = NewListener[T]()
+		tb.topics[topic] = mgr
+	}
+	tb.mu.Unlock()
+
+	return mgr.Add(cb, mode)
+}
+
+// Unsubscribe removes a listener previously registered with Subscribe for
+// topic. If the topic or listener does not exist, the call has no effect.
+func (tb *TopicBus[T]) Unsubscribe(topic string, id ListenerID) {
+	tb.mu.RLock()
+	mgr, ok := tb.topics[topic]
+	tb.mu.RUnlock()
+
+	if ok {
+		mgr.Remove(id)
+	}
+}
+
+// SubscribeAll registers callback to receive events published on every
+// topic, in addition to whatever topic-specific listeners fire.
+//
+// Returns a ListenerID that can be passed to UnsubscribeAll to remove the
+// listener later.
+func (tb *TopicBus[T]) SubscribeAll(cb T, mode HookMode) ListenerID {
+	return tb.wildcard.Add(cb, mode)
+}
+
+// UnsubscribeAll removes a listener previously registered with
+// SubscribeAll. If the listener does not exist, the call has no effect.
+func (tb *TopicBus[T]) UnsubscribeAll(id ListenerID) {
+	tb.wildcard.Remove(id)
+}
+
+// PublishPre invokes the Pre listeners registered for topic, then the Pre
+// listeners registered via SubscribeAll, propagating the highest
+// PluginResult across both. If the topic-scoped listeners already reach
+// Handled or Stop, the wildcard listeners are skipped.
+func (tb *TopicBus[T]) PublishPre(topic string, invokeFunc func(T) PluginResult) PluginResult {
+	tb.mu.RLock()
+	mgr, ok := tb.topics[topic]
+	tb.mu.RUnlock()
+
+	result := Continue
+	if ok {
+		result = mgr.InvokePre(invokeFunc)
+	}
+
+	if result >= Handled {
+		return result
+	}
+
+	if wildcardResult := tb.wildcard.InvokePre(invokeFunc); wildcardResult > result {
+		result = wildcardResult
+	}
+
+	return result
+}
+
+// PublishPost invokes the Post listeners registered for topic, then the
+// Post listeners registered via SubscribeAll. Post listeners do not affect
+// control flow and their results are ignored.
+func (tb *TopicBus[T]) PublishPost(topic string, invokeFunc func(T)) {
+	tb.mu.RLock()
+	mgr, ok := tb.topics[topic]
+	tb.mu.RUnlock()
+
+	if ok {
+		mgr.InvokePost(invokeFunc)
+	}
+
+	tb.wildcard.InvokePost(invokeFunc)
+}
@@ -0,0 +1,141 @@
+package listeners
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestTopicBusPublishPreOrdersTopicBeforeWildcard(t *testing.T) {
+	bus := NewTopicBus[func(string) PluginResult]()
+
+	var calls []string
+	bus.Subscribe("orders", func(string) PluginResult {
+		calls = append(calls, "topic")
+		return Continue
+	}, Pre)
+	bus.SubscribeAll(func(string) PluginResult {
+		calls = append(calls, "wildcard")
+		return Continue
+	}, Pre)
+
+	bus.PublishPre("orders", func(cb func(string) PluginResult) PluginResult {
+		return cb("created")
+	})
+
+	want := []string{"topic", "wildcard"}
+	if len(calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Fatalf("calls = %v, want %v", calls, want)
+		}
+	}
+}
+
+func TestTopicBusPublishPreStopShortCircuitsWildcard(t *testing.T) {
+	bus := NewTopicBus[func(string) PluginResult]()
+
+	wildcardCalled := false
+	bus.Subscribe("orders", func(string) PluginResult {
+		return Stop
+	}, Pre)
+	bus.SubscribeAll(func(string) PluginResult {
+		wildcardCalled = true
+		return Continue
+	}, Pre)
+
+	result := bus.PublishPre("orders", func(cb func(string) PluginResult) PluginResult {
+		return cb("created")
+	})
+
+	if result != Stop {
+		t.Fatalf("result = %d, want Stop", result)
+	}
+	if wildcardCalled {
+		t.Fatal("wildcard listener ran after a topic listener returned Stop")
+	}
+}
+
+func TestTopicBusPublishPreCombinesHandledAcrossTopicAndWildcard(t *testing.T) {
+	bus := NewTopicBus[func(string) PluginResult]()
+
+	bus.Subscribe("orders", func(string) PluginResult {
+		return Continue
+	}, Pre)
+	bus.SubscribeAll(func(string) PluginResult {
+		return Handled
+	}, Pre)
+
+	result := bus.PublishPre("orders", func(cb func(string) PluginResult) PluginResult {
+		return cb("created")
+	})
+
+	if result != Handled {
+		t.Fatalf("result = %d, want Handled", result)
+	}
+}
+
+func TestTopicBusUnknownTopicOnlyRunsWildcard(t *testing.T) {
+	bus := NewTopicBus[func(string) PluginResult]()
+
+	wildcardCalled := false
+	bus.SubscribeAll(func(string) PluginResult {
+		wildcardCalled = true
+		return Continue
+	}, Pre)
+
+	bus.PublishPre("never-subscribed", func(cb func(string) PluginResult) PluginResult {
+		return cb("x")
+	})
+
+	if !wildcardCalled {
+		t.Fatal("wildcard listener did not run for a topic with no direct subscribers")
+	}
+}
+
+func TestTopicBusConcurrentSubscribeAndPublish(t *testing.T) {
+	bus := NewTopicBus[func(int) PluginResult]()
+
+	const topics = 8
+	const publishersPerTopic = 50
+
+	var total atomic.Int32
+	var wg sync.WaitGroup
+
+	for t := 0; t < topics; t++ {
+		topic := topicName(t)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			bus.Subscribe(topic, func(n int) PluginResult {
+				total.Add(int32(n))
+				return Continue
+			}, Pre)
+		}()
+	}
+	wg.Wait()
+
+	for t := 0; t < topics; t++ {
+		topic := topicName(t)
+		for p := 0; p < publishersPerTopic; p++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				bus.PublishPre(topic, func(cb func(int) PluginResult) PluginResult {
+					return cb(1)
+				})
+			}()
+		}
+	}
+	wg.Wait()
+
+	if got, want := total.Load(), int32(topics*publishersPerTopic); got != want {
+		t.Fatalf("total = %d, want %d", got, want)
+	}
+}
+
+func topicName(i int) string {
+	return [...]string{"t0", "t1", "t2", "t3", "t4", "t5", "t6", "t7"}[i]
+}